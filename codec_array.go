@@ -68,6 +68,9 @@ func (d *arrayDecoder) Decode(ptr unsafe.Pointer, r *Reader) {
 		}
 	}
 
+	// An empty array must still truncate a slice being decoded into.
+	sliceType.UnsafeGrow(ptr, size)
+
 	if r.Error != nil && !errors.Is(r.Error, io.EOF) {
 		r.Error = fmt.Errorf("%v: %w", d.typ, r.Error)
 	}
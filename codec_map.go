@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
 	"unsafe"
 
 	"github.com/modern-go/reflect2"
@@ -149,6 +150,7 @@ func encoderOfMap(e *encoderContext, m *MapSchema, typ reflect2.Type) ValEncoder
 
 	return &mapEncoder{
 		blockLength: e.cfg.getBlockLength(),
+		sortKeys:    e.cfg.config.SortMapKeys,
 		mapType:     mapType,
 		encoder:     encoder,
 	}
@@ -156,11 +158,17 @@ func encoderOfMap(e *encoderContext, m *MapSchema, typ reflect2.Type) ValEncoder
 
 type mapEncoder struct {
 	blockLength int
+	sortKeys    bool
 	mapType     *reflect2.UnsafeMapType
 	encoder     ValEncoder
 }
 
 func (e *mapEncoder) Encode(ptr unsafe.Pointer, w *Writer) {
+	if e.sortKeys {
+		e.encodeSorted(ptr, w)
+		return
+	}
+
 	blockLength := e.blockLength
 
 	iter := e.mapType.UnsafeIterate(ptr)
@@ -187,12 +195,28 @@ func (e *mapEncoder) Encode(ptr unsafe.Pointer, w *Writer) {
 	}
 }
 
+func (e *mapEncoder) encodeSorted(ptr unsafe.Pointer, w *Writer) {
+	entries := make([]mapEntry, 0, mapLen(e.mapType, ptr))
+	iter := e.mapType.UnsafeIterate(ptr)
+	for iter.HasNext() {
+		keyPtr, elemPtr := iter.UnsafeNext()
+		entries = append(entries, mapEntry{key: *((*string)(keyPtr)), elemPtr: elemPtr})
+	}
+
+	encodeSortedMapEntries(w, e.blockLength, entries, e.encoder)
+
+	if w.Error != nil && !errors.Is(w.Error, io.EOF) {
+		w.Error = fmt.Errorf("%v: %w", e.mapType, w.Error)
+	}
+}
+
 func encoderOfMapMarshaler(e *encoderContext, m *MapSchema, typ reflect2.Type) ValEncoder {
 	mapType := typ.(*reflect2.UnsafeMapType)
 	encoder := encoderOfType(e, m.Values(), mapType.Elem())
 
 	return &mapEncoderMarshaller{
 		blockLength: e.cfg.getBlockLength(),
+		sortKeys:    e.cfg.config.SortMapKeys,
 		mapType:     mapType,
 		keyType:     mapType.Key(),
 		encoder:     encoder,
@@ -201,12 +225,18 @@ func encoderOfMapMarshaler(e *encoderContext, m *MapSchema, typ reflect2.Type) V
 
 type mapEncoderMarshaller struct {
 	blockLength int
+	sortKeys    bool
 	mapType     *reflect2.UnsafeMapType
 	keyType     reflect2.Type
 	encoder     ValEncoder
 }
 
 func (e *mapEncoderMarshaller) Encode(ptr unsafe.Pointer, w *Writer) {
+	if e.sortKeys {
+		e.encodeSorted(ptr, w)
+		return
+	}
+
 	blockLength := e.blockLength
 
 	iter := e.mapType.UnsafeIterate(ptr)
@@ -217,18 +247,12 @@ func (e *mapEncoderMarshaller) Encode(ptr unsafe.Pointer, w *Writer) {
 			for i = 0; iter.HasNext() && i < blockLength; i++ {
 				keyPtr, elemPtr := iter.UnsafeNext()
 
-				obj := e.keyType.UnsafeIndirect(keyPtr)
-				if e.keyType.IsNullable() && reflect2.IsNil(obj) {
-					w.Error = errors.New("avro: mapEncoderMarshaller: encoding nil TextMarshaller")
-					return int64(0)
-				}
-				marshaler := (obj).(encoding.TextMarshaler)
-				b, err := marshaler.MarshalText()
+				key, err := e.marshalKey(keyPtr)
 				if err != nil {
 					w.Error = err
 					return int64(0)
 				}
-				w.WriteString(string(b))
+				w.WriteString(key)
 
 				e.encoder.Encode(elemPtr, w)
 			}
@@ -244,3 +268,68 @@ func (e *mapEncoderMarshaller) Encode(ptr unsafe.Pointer, w *Writer) {
 		w.Error = fmt.Errorf("%v: %w", e.mapType, w.Error)
 	}
 }
+
+func (e *mapEncoderMarshaller) encodeSorted(ptr unsafe.Pointer, w *Writer) {
+	entries := make([]mapEntry, 0, mapLen(e.mapType, ptr))
+	iter := e.mapType.UnsafeIterate(ptr)
+	for iter.HasNext() {
+		keyPtr, elemPtr := iter.UnsafeNext()
+		key, err := e.marshalKey(keyPtr)
+		if err != nil {
+			w.Error = fmt.Errorf("%v: %w", e.mapType, err)
+			return
+		}
+		entries = append(entries, mapEntry{key: key, elemPtr: elemPtr})
+	}
+
+	encodeSortedMapEntries(w, e.blockLength, entries, e.encoder)
+
+	if w.Error != nil && !errors.Is(w.Error, io.EOF) {
+		w.Error = fmt.Errorf("%v: %w", e.mapType, w.Error)
+	}
+}
+
+func (e *mapEncoderMarshaller) marshalKey(keyPtr unsafe.Pointer) (string, error) {
+	obj := e.keyType.UnsafeIndirect(keyPtr)
+	if e.keyType.IsNullable() && reflect2.IsNil(obj) {
+		return "", errors.New("avro: mapEncoderMarshaller: encoding nil TextMarshaller")
+	}
+	marshaler := (obj).(encoding.TextMarshaler)
+	b, err := marshaler.MarshalText()
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// mapEntry is a map key paired with a pointer to its value.
+type mapEntry struct {
+	key     string
+	elemPtr unsafe.Pointer
+}
+
+// mapLen returns the number of entries in the map at ptr.
+func mapLen(mapType *reflect2.UnsafeMapType, ptr unsafe.Pointer) int {
+	return reflect.ValueOf(mapType.UnsafeIndirect(ptr)).Len()
+}
+
+// encodeSortedMapEntries writes the entries as map blocks, ordered by key.
+func encodeSortedMapEntries(w *Writer, blockLength int, entries []mapEntry, encoder ValEncoder) {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].key < entries[j].key
+	})
+
+	for i := 0; i < len(entries); i += blockLength {
+		w.WriteBlockCB(func(w *Writer) int64 {
+			count := int64(0)
+			for j := i; j < i+blockLength && j < len(entries); j++ {
+				w.WriteString(entries[j].key)
+				encoder.Encode(entries[j].elemPtr, w)
+				count++
+			}
+			return count
+		})
+	}
+
+	w.WriteBlockHeader(0, 0)
+}
@@ -32,6 +32,12 @@ type Config struct {
 	// Avro specification, however not all decoders support the latter.
 	DisableBlockSizeHeader bool
 
+	// SortMapKeys determines if map keys are encoded in sorted order.
+	// By default maps are encoded in Go's map iteration order, which is random.
+	// Sorting the keys makes the encoding of maps deterministic, at the cost
+	// of buffering the map entries before encoding them.
+	SortMapKeys bool
+
 	// UnionResolutionError determines if an error will be returned
 	// when a type cannot be resolved while decoding a union.
 	UnionResolutionError bool
@@ -37,6 +37,36 @@ func TestDecoder_ArraySlice(t *testing.T) {
 	assert.Equal(t, []int{27, 28}, got)
 }
 
+func TestDecoder_ArraySliceReusesBackingArray(t *testing.T) {
+	defer ConfigTeardown()
+
+	data := []byte{0x04, 0x36, 0x38, 0x0}
+	schema := `{"type":"array", "items": "int"}`
+	dec, _ := avro.NewDecoder(schema, bytes.NewReader(data))
+
+	backing := make([]int, 3, 4)
+	got := backing
+	err := dec.Decode(&got)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{27, 28}, got)
+	assert.Same(t, &backing[0], &got[0])
+}
+
+func TestDecoder_ArraySliceEmptyTruncates(t *testing.T) {
+	defer ConfigTeardown()
+
+	data := []byte{0x0}
+	schema := `{"type":"array", "items": "int"}`
+	dec, _ := avro.NewDecoder(schema, bytes.NewReader(data))
+
+	got := []int{1, 2, 3}
+	err := dec.Decode(&got)
+
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
 func TestDecoder_ArraySliceShortRead(t *testing.T) {
 	defer ConfigTeardown()
 
@@ -64,6 +94,37 @@ func TestDecoder_ArraySliceOfStruct(t *testing.T) {
 	assert.Equal(t, []TestRecord{{A: 27, B: "foo"}, {A: 27, B: "foo"}}, got)
 }
 
+func TestDecoder_ArraySliceOfNullableUnionPtr(t *testing.T) {
+	defer ConfigTeardown()
+
+	data := []byte{0x04, 0x00, 0x02, 0x06, 0x66, 0x6f, 0x6f, 0x0}
+	schema := `{"type":"array", "items": ["null", "string"]}`
+	dec, _ := avro.NewDecoder(schema, bytes.NewReader(data))
+
+	var got []*string
+	err := dec.Decode(&got)
+
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Nil(t, got[0])
+	require.NotNil(t, got[1])
+	assert.Equal(t, "foo", *got[1])
+}
+
+func TestDecoder_ArraySliceOfUnionInterface(t *testing.T) {
+	defer ConfigTeardown()
+
+	data := []byte{0x06, 0x00, 0x02, 0x06, 0x66, 0x6f, 0x6f, 0x04, 0x36, 0x0}
+	schema := `{"type":"array", "items": ["null", "string", "int"]}`
+	dec, _ := avro.NewDecoder(schema, bytes.NewReader(data))
+
+	var got []any
+	err := dec.Decode(&got)
+
+	require.NoError(t, err)
+	assert.Equal(t, []any{nil, "foo", 27}, got)
+}
+
 func TestDecoder_ArrayRecursiveStruct(t *testing.T) {
 	defer ConfigTeardown()
 
@@ -38,6 +38,20 @@ func TestDecoder_Enum(t *testing.T) {
 	assert.Equal(t, "bar", got)
 }
 
+func TestDecoder_EnumNamedString(t *testing.T) {
+	defer ConfigTeardown()
+
+	data := []byte{0x02}
+	schema := `{"type":"enum", "name": "test", "symbols": ["foo", "bar"]}`
+	dec, _ := avro.NewDecoder(schema, bytes.NewReader(data))
+
+	var got testEnumString
+	err := dec.Decode(&got)
+
+	require.NoError(t, err)
+	assert.Equal(t, testEnumStringBar, got)
+}
+
 func TestDecoder_EnumInvalidSymbol(t *testing.T) {
 	defer ConfigTeardown()
 
@@ -158,6 +172,13 @@ func TestDecoder_EnumTextUnmarshalerError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+type testEnumString string
+
+const (
+	testEnumStringFoo testEnumString = "foo"
+	testEnumStringBar testEnumString = "bar"
+)
+
 type testEnumUnmarshalerObj struct {
 	A testEnumTextUnmarshaler `avro:"a"`
 }
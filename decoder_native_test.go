@@ -464,6 +464,37 @@ func TestDecoder_Bytes(t *testing.T) {
 	assert.Equal(t, []byte{0xEC, 0xAB, 0x44, 0x00}, b)
 }
 
+func TestDecoder_BytesDoesNotAliasInput(t *testing.T) {
+	defer ConfigTeardown()
+
+	tests := []struct {
+		name string
+		size int
+	}{
+		{name: "small", size: 4},
+		{name: "large", size: 2048},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schema := avro.MustParse("bytes")
+			want := bytes.Repeat([]byte{0xEC}, test.size)
+			data, err := avro.Marshal(schema, want)
+			require.NoError(t, err)
+
+			var got []byte
+			err = avro.Unmarshal(schema, data, &got)
+			require.NoError(t, err)
+
+			for i := range data {
+				data[i] = 0
+			}
+
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
 func TestDecoder_BytesShortRead(t *testing.T) {
 	defer ConfigTeardown()
 
@@ -3,6 +3,7 @@ package avro_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/hamba/avro/v2"
 	"github.com/stretchr/testify/assert"
@@ -119,6 +120,29 @@ func TestDecoder_RecordPartialStruct(t *testing.T) {
 	assert.Equal(t, TestPartialRecord{B: "foo"}, got)
 }
 
+func TestDecoder_RecordStructWithExtraFields(t *testing.T) {
+	defer ConfigTeardown()
+
+	data := []byte{0x36, 0x06, 0x66, 0x6f, 0x6f}
+	schema := `{
+	"type": "record",
+	"name": "test",
+	"fields" : [
+		{"name": "a", "type": "long"},
+	    {"name": "b", "type": "string"}
+	]
+}`
+	dec, err := avro.NewDecoder(schema, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	got := TestExtraFieldsRecord{ReceivedAt: now, Seq: 7}
+	err = dec.Decode(&got)
+
+	require.NoError(t, err)
+	assert.Equal(t, TestExtraFieldsRecord{A: 27, B: "foo", ReceivedAt: now, Seq: 7}, got)
+}
+
 func TestDecoder_RecordStructInvalidData(t *testing.T) {
 	defer ConfigTeardown()
 
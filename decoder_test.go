@@ -79,6 +79,17 @@ func TestUnmarshal(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestUnmarshal_EmptyRecord(t *testing.T) {
+	defer ConfigTeardown()
+
+	schema := avro.MustParse(`{"type":"record", "name":"test", "fields":[]}`)
+
+	var got struct{}
+	err := avro.Unmarshal(schema, []byte{}, &got)
+
+	assert.NoError(t, err)
+}
+
 func TestUnmarshal_Ptr(t *testing.T) {
 	defer ConfigTeardown()
 
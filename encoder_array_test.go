@@ -64,6 +64,35 @@ func TestEncoder_ArrayOfStruct(t *testing.T) {
 	assert.Equal(t, []byte{0x03, 0x14, 0x36, 0x06, 0x66, 0x6f, 0x6f, 0x36, 0x06, 0x66, 0x6f, 0x6f, 0x0}, buf.Bytes())
 }
 
+func TestEncoder_ArrayOfNullableUnionPtr(t *testing.T) {
+	defer ConfigTeardown()
+
+	schema := `{"type":"array", "items": ["null", "string"]}`
+	buf := bytes.NewBuffer([]byte{})
+	enc, err := avro.NewEncoder(schema, buf)
+	require.NoError(t, err)
+
+	str := "foo"
+	err = enc.Encode([]*string{nil, &str})
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x03, 0x0c, 0x00, 0x02, 0x06, 0x66, 0x6f, 0x6f, 0x0}, buf.Bytes())
+}
+
+func TestEncoder_ArrayOfUnionInterface(t *testing.T) {
+	defer ConfigTeardown()
+
+	schema := `{"type":"array", "items": ["null", "string", "int"]}`
+	buf := bytes.NewBuffer([]byte{})
+	enc, err := avro.NewEncoder(schema, buf)
+	require.NoError(t, err)
+
+	err = enc.Encode([]any{nil, "foo", 27})
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x05, 0x10, 0x00, 0x02, 0x06, 0x66, 0x6f, 0x6f, 0x04, 0x36, 0x0}, buf.Bytes())
+}
+
 func TestEncoder_ArrayRecursiveStruct(t *testing.T) {
 	defer ConfigTeardown()
 
@@ -37,6 +37,20 @@ func TestEncoder_Enum(t *testing.T) {
 	assert.Equal(t, []byte{0x02}, buf.Bytes())
 }
 
+func TestEncoder_EnumNamedString(t *testing.T) {
+	defer ConfigTeardown()
+
+	schema := `{"type":"enum", "name": "test", "symbols": ["foo", "bar"]}`
+	buf := bytes.NewBuffer([]byte{})
+	enc, err := avro.NewEncoder(schema, buf)
+	require.NoError(t, err)
+
+	err = enc.Encode(testEnumStringFoo)
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x00}, buf.Bytes())
+}
+
 func TestEncoder_EnumInvalidSymbol(t *testing.T) {
 	defer ConfigTeardown()
 
@@ -150,6 +150,98 @@ func TestEncoder_MapWithMoreThanBlockLengthKeys(t *testing.T) {
 	})
 }
 
+func TestEncoder_MapSortedKeys(t *testing.T) {
+	defer ConfigTeardown()
+
+	avro.DefaultConfig = avro.Config{SortMapKeys: true}.Freeze()
+
+	schema := `{"type":"map", "values": "int"}`
+	buf := bytes.NewBuffer([]byte{})
+	enc, err := avro.NewEncoder(schema, buf)
+	require.NoError(t, err)
+
+	err = enc.Encode(map[string]int{"foo": 1, "bar": 2})
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x03, 0x14, 0x06, 0x62, 0x61, 0x72, 0x04, 0x06, 0x66, 0x6F, 0x6F, 0x02, 0x00}, buf.Bytes())
+}
+
+func TestEncoder_MapSortedKeysWithMoreThanBlockLengthKeys(t *testing.T) {
+	defer ConfigTeardown()
+
+	avro.DefaultConfig = avro.Config{
+		BlockLength: 1,
+		SortMapKeys: true,
+	}.Freeze()
+
+	schema := `{"type":"map", "values": "int"}`
+	buf := bytes.NewBuffer([]byte{})
+	enc, err := avro.NewEncoder(schema, buf)
+	require.NoError(t, err)
+
+	err = enc.Encode(map[string]int{"foo": 1, "bar": 2})
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x0a, 0x06, 0x62, 0x61, 0x72, 0x04, 0x01, 0x0a, 0x06, 0x66, 0x6F, 0x6F, 0x02, 0x0}, buf.Bytes())
+}
+
+func TestEncoder_MapSortedKeysIsDeterministic(t *testing.T) {
+	defer ConfigTeardown()
+
+	avro.DefaultConfig = avro.Config{SortMapKeys: true}.Freeze()
+
+	schema := avro.MustParse(`{"type":"map", "values": "int"}`)
+	m := make(map[string]int, 100)
+	for i := range 100 {
+		m[strconv.Itoa(i)] = i
+	}
+
+	first, err := avro.Marshal(schema, m)
+	require.NoError(t, err)
+	for range 10 {
+		got, err := avro.Marshal(schema, m)
+		require.NoError(t, err)
+		assert.Equal(t, first, got)
+	}
+}
+
+func TestEncoder_MapMarshallerSortedKeys(t *testing.T) {
+	defer ConfigTeardown()
+
+	avro.DefaultConfig = avro.Config{SortMapKeys: true}.Freeze()
+
+	schema := `{"type":"map", "values": "string"}`
+	buf := bytes.NewBuffer([]byte{})
+	enc, err := avro.NewEncoder(schema, buf)
+	require.NoError(t, err)
+
+	err = enc.Encode(map[textMarshallerInt]string{
+		2: "b",
+		1: "a",
+	})
+
+	require.NoError(t, err)
+	want := []byte{0x03, 0x10, 0x02, 0x31, 0x02, 0x61, 0x02, 0x32, 0x02, 0x62, 0x00}
+	assert.Equal(t, want, buf.Bytes())
+}
+
+func TestEncoder_MapMarshallerSortedKeysKeyError(t *testing.T) {
+	defer ConfigTeardown()
+
+	avro.DefaultConfig = avro.Config{SortMapKeys: true}.Freeze()
+
+	schema := `{"type":"map", "values": "string"}`
+	buf := bytes.NewBuffer([]byte{})
+	enc, err := avro.NewEncoder(schema, buf)
+	require.NoError(t, err)
+
+	err = enc.Encode(map[textMarshallerError]string{
+		1: "a",
+	})
+
+	require.Error(t, err)
+}
+
 type textMarshallerInt int
 
 func (t textMarshallerInt) MarshalText() (text []byte, err error) {
@@ -3,6 +3,7 @@ package avro_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/hamba/avro/v2"
 	"github.com/stretchr/testify/assert"
@@ -74,6 +75,28 @@ func TestEncoder_RecordStructPtrNil(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestEncoder_RecordStructWithExtraFields(t *testing.T) {
+	defer ConfigTeardown()
+
+	schema := `{
+	"type": "record",
+	"name": "test",
+	"fields" : [
+		{"name": "a", "type": "long"},
+	    {"name": "b", "type": "string"}
+	]
+}`
+	obj := TestExtraFieldsRecord{A: 27, B: "foo", ReceivedAt: time.Now(), Seq: 7}
+	buf := &bytes.Buffer{}
+	enc, err := avro.NewEncoder(schema, buf)
+	require.NoError(t, err)
+
+	err = enc.Encode(obj)
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x36, 0x06, 0x66, 0x6f, 0x6f}, buf.Bytes())
+}
+
 func TestEncoder_RecordStructMissingRequiredField(t *testing.T) {
 	defer ConfigTeardown()
 
@@ -41,6 +41,17 @@ func TestMarshal(t *testing.T) {
 	assert.Equal(t, []byte{0x01}, b)
 }
 
+func TestMarshal_EmptyRecord(t *testing.T) {
+	defer ConfigTeardown()
+
+	schema := avro.MustParse(`{"type":"record", "name":"test", "fields":[]}`)
+
+	b, err := avro.Marshal(schema, struct{}{})
+
+	require.NoError(t, err)
+	assert.Empty(t, b)
+}
+
 func TestMarshal_Error(t *testing.T) {
 	defer ConfigTeardown()
 
@@ -299,7 +299,8 @@ func (r *Reader) readBytes(op string) []byte {
 		if cap(r.slab) < size {
 			r.slab = make([]byte, 1024)
 		}
-		dst := r.slab[:size]
+		// Cap the slice so appending to it cannot overwrite later values in the slab.
+		dst := r.slab[:size:size]
 		r.slab = r.slab[size:]
 		copy(dst, r.buf[r.head:r.head+size])
 		r.head += size
@@ -565,6 +565,18 @@ func TestReader_ReadBytes(t *testing.T) {
 	}
 }
 
+func TestReader_ReadBytesAppendDoesNotOverwriteNextValue(t *testing.T) {
+	data := []byte{0x04, 0x01, 0x02, 0x06, 0x66, 0x6f, 0x6f}
+	r := avro.NewReader(bytes.NewReader(data), 10)
+
+	b := r.ReadBytes()
+	s := r.ReadString()
+	_ = append(b, 0xFF, 0xFF)
+
+	require.NoError(t, r.Error)
+	assert.Equal(t, "foo", s)
+}
+
 func TestReader_ReadBytesLargerThanMaxByteSliceSize(t *testing.T) {
 	data := []byte{
 		246, 255, 255, 255, 255, 10, 255, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
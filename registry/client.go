@@ -7,6 +7,7 @@ package registry
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -89,12 +90,12 @@ type schemaInfoPayload struct {
 
 // Parse converts the string schema registry response into a
 // SchemaInfo object with an avro.Schema schema.
-func (s *schemaInfoPayload) Parse() (info SchemaInfo, err error) {
+func (s *schemaInfoPayload) Parse(parse SchemaParser) (info SchemaInfo, err error) {
 	info = SchemaInfo{
 		ID:      s.ID,
 		Version: s.Version,
 	}
-	info.Schema, err = avro.Parse(s.Schema)
+	info.Schema, err = parse(s.Schema)
 	return info, err
 }
 
@@ -118,6 +119,9 @@ var defaultClient = &http.Client{
 	Timeout: 10 * time.Second,
 }
 
+// SchemaParser parses a schema returned by, or sent to, the registry.
+type SchemaParser func(schema string) (avro.Schema, error)
+
 // ClientFunc is a function used to customize the Client.
 type ClientFunc func(*Client)
 
@@ -135,12 +139,24 @@ func WithBasicAuth(username, password string) ClientFunc {
 	}
 }
 
+// WithSchemaParser sets the function used to parse schemas, defaulting
+// to avro.Parse. A nil parser leaves the default in place.
+func WithSchemaParser(parse SchemaParser) ClientFunc {
+	return func(c *Client) {
+		if parse == nil {
+			return
+		}
+		c.parse = parse
+	}
+}
+
 // Client is an HTTP registry client.
 type Client struct {
 	client *http.Client
 	base   *url.URL
 
 	creds credentials
+	parse SchemaParser
 
 	cache sync.Map // map[int]avro.Schema
 }
@@ -158,6 +174,7 @@ func NewClient(baseURL string, opts ...ClientFunc) (*Client, error) {
 	c := &Client{
 		client: defaultClient,
 		base:   u,
+		parse:  avro.Parse,
 	}
 
 	for _, opt := range opts {
@@ -182,7 +199,7 @@ func (c *Client) GetSchema(ctx context.Context, id int) (avro.Schema, error) {
 		return nil, err
 	}
 
-	schema, err := avro.Parse(resp.Schema)
+	schema, err := c.parse(resp.Schema)
 	if err != nil {
 		return nil, err
 	}
@@ -192,6 +209,60 @@ func (c *Client) GetSchema(ctx context.Context, id int) (avro.Schema, error) {
 	return schema, nil
 }
 
+// Preload fetches and caches the schemas with the given ids concurrently,
+// with at most 10 requests in flight. Duplicate ids are fetched once.
+//
+// A failure to fetch one schema does not stop the others from being fetched.
+// The returned error joins the errors of all failed ids. Once ctx is done no
+// further ids are fetched, and they are reported together by a single error.
+func (c *Client) Preload(ctx context.Context, ids ...int) error {
+	const maxInFlight = 10
+
+	seen := make(map[int]struct{}, len(ids))
+	unique := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	errs := make([]error, len(unique))
+	sem := make(chan struct{}, maxInFlight)
+
+	var (
+		wg      sync.WaitGroup
+		stopErr error
+	)
+	for i, id := range unique {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		// Stop dispatching once ctx is done, even if a slot was acquired.
+		if err := ctx.Err(); err != nil {
+			stopErr = fmt.Errorf("preloading %d schemas: %w", len(unique)-i, err)
+			break
+		}
+
+		wg.Add(1)
+		go func(i, id int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if _, err := c.GetSchema(ctx, id); err != nil {
+				errs[i] = fmt.Errorf("preloading schema %d: %w", id, err)
+			}
+		}(i, id)
+	}
+	wg.Wait()
+
+	return errors.Join(append(errs, stopErr)...)
+}
+
 // GetSubjects gets the registry subjects.
 func (c *Client) GetSubjects(ctx context.Context) ([]string, error) {
 	var subjects []string
@@ -229,7 +300,7 @@ func (c *Client) GetSchemaByVersion(ctx context.Context, subject string, version
 	if err := c.request(ctx, http.MethodGet, p, nil, &resp); err != nil {
 		return nil, err
 	}
-	return avro.Parse(resp.Schema)
+	return c.parse(resp.Schema)
 }
 
 // GetLatestSchema gets the latest schema for a subject.
@@ -239,7 +310,7 @@ func (c *Client) GetLatestSchema(ctx context.Context, subject string) (avro.Sche
 	if err := c.request(ctx, http.MethodGet, p, nil, &resp); err != nil {
 		return nil, err
 	}
-	return avro.Parse(resp.Schema)
+	return c.parse(resp.Schema)
 }
 
 // GetSchemaInfo gets the schema and schema metadata for a subject and version.
@@ -249,7 +320,7 @@ func (c *Client) GetSchemaInfo(ctx context.Context, subject string, version int)
 	if err := c.request(ctx, http.MethodGet, p, nil, &resp); err != nil {
 		return SchemaInfo{}, err
 	}
-	return resp.Parse()
+	return resp.Parse(c.parse)
 }
 
 // GetLatestSchemaInfo gets the latest schema and schema metadata for a subject.
@@ -259,7 +330,64 @@ func (c *Client) GetLatestSchemaInfo(ctx context.Context, subject string) (Schem
 	if err := c.request(ctx, http.MethodGet, p, nil, &resp); err != nil {
 		return SchemaInfo{}, err
 	}
-	return resp.Parse()
+	return resp.Parse(c.parse)
+}
+
+// WatchSubjects polls the latest schema of each subject every interval,
+// calling onChange when the latest version of a subject changes.
+//
+// The versions found by the first successful lookup of a subject are
+// recorded without calling onChange. A failed lookup is passed to onError,
+// if it is not nil, and retried at the next interval. WatchSubjects blocks
+// until ctx is done, returning the context error, and should usually be run
+// in its own goroutine. The interval must be positive and onChange must not
+// be nil.
+func (c *Client) WatchSubjects(
+	ctx context.Context,
+	subjects []string,
+	interval time.Duration,
+	onChange func(subject string, info SchemaInfo),
+	onError func(subject string, err error),
+) error {
+	if interval <= 0 {
+		return fmt.Errorf("invalid watch interval %s", interval)
+	}
+	if onChange == nil {
+		return errors.New("onChange must not be nil")
+	}
+
+	versions := make(map[string]int, len(subjects))
+	poll := func() {
+		for _, subject := range subjects {
+			info, err := c.GetLatestSchemaInfo(ctx, subject)
+			if err != nil {
+				if onError != nil && ctx.Err() == nil {
+					onError(subject, err)
+				}
+				continue
+			}
+
+			prev, ok := versions[subject]
+			versions[subject] = info.Version
+			if ok && prev != info.Version {
+				onChange(subject, info)
+			}
+		}
+	}
+
+	poll()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			poll()
+		}
+	}
 }
 
 // CreateSchema creates a schema in the registry, returning the schema id.
@@ -275,7 +403,7 @@ func (c *Client) CreateSchema(
 		return 0, nil, err
 	}
 
-	sch, err := avro.Parse(schema)
+	sch, err := c.parse(schema)
 	return resp.ID, sch, err
 }
 
@@ -296,7 +424,7 @@ func (c *Client) IsRegisteredWithRefs(
 		return 0, nil, err
 	}
 
-	sch, err := avro.Parse(schema)
+	sch, err := c.parse(schema)
 	return resp.ID, sch, err
 }
 
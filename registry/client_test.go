@@ -6,8 +6,13 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hamba/avro/v2"
 	"github.com/hamba/avro/v2/registry"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -95,6 +100,38 @@ func TestClient_GetSchemaCachesSchema(t *testing.T) {
 	assert.Equal(t, 1, count)
 }
 
+func TestClient_GetSchemaWithSchemaParser(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"schema":"{\"type\":\"record\",\"name\":\"test\",\"fields\":[{\"name\":\"a\",\"type\":\"int\"}]}"}`))
+	}))
+	t.Cleanup(s.Close)
+	var got []string
+	parse := func(schema string) (avro.Schema, error) {
+		got = append(got, schema)
+		return avro.Parse(strings.ReplaceAll(schema, `"name":"a"`, `"name":"A"`))
+	}
+	client, _ := registry.NewClient(s.URL, registry.WithSchemaParser(parse))
+
+	schema, err := client.GetSchema(context.Background(), 5)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{`{"type":"record","name":"test","fields":[{"name":"a","type":"int"}]}`}, got)
+	assert.Equal(t, `{"name":"test","type":"record","fields":[{"name":"A","type":"int"}]}`, schema.String())
+}
+
+func TestClient_GetSchemaWithNilSchemaParser(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"schema":"[\"null\",\"string\",\"int\"]"}`))
+	}))
+	t.Cleanup(s.Close)
+	client, _ := registry.NewClient(s.URL, registry.WithSchemaParser(nil))
+
+	schema, err := client.GetSchema(context.Background(), 5)
+
+	require.NoError(t, err)
+	assert.Equal(t, `["null","string","int"]`, schema.String())
+}
+
 func TestClient_GetSchemaRequestError(t *testing.T) {
 	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(500)
@@ -131,6 +168,109 @@ func TestClient_GetSchemaSchemaError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestClient_Preload(t *testing.T) {
+	var count atomic.Int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		_, _ = w.Write([]byte(`{"schema":"[\"null\",\"string\",\"int\"]"}`))
+	}))
+	t.Cleanup(s.Close)
+	client, _ := registry.NewClient(s.URL)
+
+	err := client.Preload(context.Background(), 5, 6)
+	require.NoError(t, err)
+
+	_, _ = client.GetSchema(context.Background(), 5)
+	_, _ = client.GetSchema(context.Background(), 6)
+
+	assert.Equal(t, int32(2), count.Load())
+}
+
+func TestClient_PreloadDuplicateIDs(t *testing.T) {
+	var count atomic.Int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		_, _ = w.Write([]byte(`{"schema":"[\"null\",\"string\",\"int\"]"}`))
+	}))
+	t.Cleanup(s.Close)
+	client, _ := registry.NewClient(s.URL)
+
+	err := client.Preload(context.Background(), 5, 6, 5, 5, 6)
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), count.Load())
+}
+
+func TestClient_PreloadCanceledContext(t *testing.T) {
+	var count atomic.Int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		_, _ = w.Write([]byte(`{"schema":"[\"null\",\"string\",\"int\"]"}`))
+	}))
+	t.Cleanup(s.Close)
+	client, _ := registry.NewClient(s.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ids := make([]int, 50)
+	for i := range ids {
+		ids[i] = i
+	}
+	err := client.Preload(ctx, ids...)
+
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, "preloading 50 schemas: context canceled", err.Error())
+	assert.Equal(t, int32(0), count.Load())
+}
+
+func TestClient_PreloadLimitsConcurrentRequests(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		_, _ = w.Write([]byte(`{"schema":"[\"null\",\"string\",\"int\"]"}`))
+	}))
+	t.Cleanup(s.Close)
+	client, _ := registry.NewClient(s.URL)
+
+	ids := make([]int, 50)
+	for i := range ids {
+		ids[i] = i
+	}
+	err := client.Preload(context.Background(), ids...)
+
+	require.NoError(t, err)
+	assert.LessOrEqual(t, peak.Load(), int32(10))
+}
+
+func TestClient_PreloadReportsEachError(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/schemas/ids/5" {
+			_, _ = w.Write([]byte(`{"schema":"[\"null\",\"string\",\"int\"]"}`))
+			return
+		}
+		w.WriteHeader(500)
+	}))
+	t.Cleanup(s.Close)
+	client, _ := registry.NewClient(s.URL)
+
+	err := client.Preload(context.Background(), 5, 6, 7)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "preloading schema 6")
+	assert.Contains(t, err.Error(), "preloading schema 7")
+	assert.NotContains(t, err.Error(), "preloading schema 5")
+}
+
 func TestClient_GetSubjects(t *testing.T) {
 	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "GET", r.Method)
@@ -454,6 +594,133 @@ func TestClient_GetLatestSchemaInfoSchemaError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestClient_WatchSubjects(t *testing.T) {
+	var version atomic.Int32
+	version.Store(1)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/subjects/foobar/versions/latest", r.URL.Path)
+
+		v := version.Load()
+		_, _ = w.Write([]byte(`{"subject":"foobar","version":` + strconv.Itoa(int(v)) + `,"id":2,"schema":"[\"null\"]"}`))
+	}))
+	t.Cleanup(s.Close)
+	client, _ := registry.NewClient(s.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	changes := make(chan registry.SchemaInfo, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- client.WatchSubjects(ctx, []string{"foobar"}, 10*time.Millisecond, func(subject string, info registry.SchemaInfo) {
+			assert.Equal(t, "foobar", subject)
+			changes <- info
+		}, nil)
+	}()
+
+	select {
+	case <-changes:
+		t.Fatal("onChange called before the version changed")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	version.Store(2)
+
+	select {
+	case info := <-changes:
+		assert.Equal(t, 2, info.Version)
+	case <-time.After(time.Second):
+		t.Fatal("onChange not called after the version changed")
+	}
+
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("WatchSubjects did not return after cancel")
+	}
+}
+
+func TestClient_WatchSubjectsRetriesFailedLookups(t *testing.T) {
+	var calls atomic.Int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(500)
+			return
+		}
+		_, _ = w.Write([]byte(`{"subject":"foobar","version":1,"id":2,"schema":"[\"null\"]"}`))
+	}))
+	t.Cleanup(s.Close)
+	client, _ := registry.NewClient(s.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	t.Cleanup(cancel)
+
+	err := client.WatchSubjects(ctx, []string{"foobar"}, 10*time.Millisecond, func(string, registry.SchemaInfo) {
+		t.Error("onChange should not be called")
+	}, nil)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Greater(t, calls.Load(), int32(1))
+}
+
+func TestClient_WatchSubjectsReportsFailedLookups(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+		_, _ = w.Write([]byte(`{"error_code":40401,"message":"Subject not found"}`))
+	}))
+	t.Cleanup(s.Close)
+	client, _ := registry.NewClient(s.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	errs := make(chan error, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- client.WatchSubjects(ctx, []string{"foobar"}, 10*time.Millisecond, func(string, registry.SchemaInfo) {
+			t.Error("onChange should not be called")
+		}, func(subject string, err error) {
+			assert.Equal(t, "foobar", subject)
+			select {
+			case errs <- err:
+			default:
+			}
+		})
+	}()
+
+	select {
+	case err := <-errs:
+		var regError registry.Error
+		require.ErrorAs(t, err, &regError)
+		assert.Equal(t, 404, regError.StatusCode)
+	case <-time.After(time.Second):
+		t.Fatal("onError not called for a failed lookup")
+	}
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestClient_WatchSubjectsInvalidInterval(t *testing.T) {
+	client, _ := registry.NewClient("http://example.com")
+
+	err := client.WatchSubjects(context.Background(), []string{"foobar"}, 0, func(string, registry.SchemaInfo) {}, nil)
+
+	assert.Error(t, err)
+}
+
+func TestClient_WatchSubjectsNilOnChange(t *testing.T) {
+	client, _ := registry.NewClient("http://example.com")
+
+	err := client.WatchSubjects(context.Background(), []string{"foobar"}, time.Second, nil, nil)
+
+	assert.Error(t, err)
+}
+
 func TestClient_CreateSchema(t *testing.T) {
 	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "POST", r.Method)
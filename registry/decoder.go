@@ -47,17 +47,29 @@ func (d *Decoder) Decode(ctx context.Context, data []byte, v any) error {
 		return errors.New("data too short")
 	}
 
+	schema, err := d.Schema(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	return d.api.Unmarshal(schema, data[5:], v)
+}
+
+// Schema returns the schema referenced by the header of data, without
+// decoding the payload.
+// The data must be formatted using the Confluent wire format, otherwise
+// an error will be returned.
+func (d *Decoder) Schema(ctx context.Context, data []byte) (avro.Schema, error) {
 	id, err := extractSchemaID(data)
 	if err != nil {
-		return fmt.Errorf("extracting schema id: %w", err)
+		return nil, fmt.Errorf("extracting schema id: %w", err)
 	}
 
 	schema, err := d.client.GetSchema(ctx, id)
 	if err != nil {
-		return fmt.Errorf("getting schema: %w", err)
+		return nil, fmt.Errorf("getting schema: %w", err)
 	}
-
-	return d.api.Unmarshal(schema, data[5:], v)
+	return schema, nil
 }
 
 func extractSchemaID(data []byte) (int, error) {
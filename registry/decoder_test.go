@@ -69,3 +69,70 @@ func TestDecoder_Decode(t *testing.T) {
 		})
 	}
 }
+
+func TestDecoder_Schema(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		schema  string
+		want    string
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:    "returns schema",
+			data:    []byte{0x0, 0x0, 0x0, 0x0, 0x2a, 0x80, 0x2},
+			schema:  `{"schema":"int"}`,
+			want:    `"int"`,
+			wantErr: require.NoError,
+		},
+		{
+			name:    "does not need a payload",
+			data:    []byte{0x0, 0x0, 0x0, 0x0, 0x2a},
+			schema:  `{"schema":"int"}`,
+			want:    `"int"`,
+			wantErr: require.NoError,
+		},
+		{
+			name:    "handles short data",
+			data:    []byte{0x0, 0x0, 0x0, 0x2a},
+			schema:  `{"schema":"int"}`,
+			wantErr: require.Error,
+		},
+		{
+			name:    "handles bad magic",
+			data:    []byte{0x1, 0x0, 0x0, 0x0, 0x2a, 0x80, 0x2},
+			schema:  `{"schema":"int"}`,
+			wantErr: require.Error,
+		},
+		{
+			name:    "handles bad schema",
+			data:    []byte{0x0, 0x0, 0x0, 0x0, 0x2a, 0x80, 0x2},
+			schema:  `{"schema":"nope"}`,
+			wantErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			h := http.NewServeMux()
+			h.Handle("/schemas/ids/42", http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, "GET", req.Method)
+
+				_, _ = rw.Write([]byte(test.schema))
+			}))
+			srv := httptest.NewServer(h)
+			t.Cleanup(srv.Close)
+
+			client, _ := registry.NewClient(srv.URL)
+			decoder := registry.NewDecoder(client)
+
+			got, err := decoder.Schema(context.Background(), test.data)
+
+			test.wantErr(t, err)
+			if test.want != "" {
+				assert.Equal(t, test.want, got.String())
+			}
+		})
+	}
+}
@@ -276,12 +276,18 @@ func (c *SchemaCompatibility) Resolve(reader, writer Schema) (Schema, error) {
 		return nil, err
 	}
 
-	schema, _, err := c.resolve(reader, writer)
+	schema, _, err := c.resolve(reader, writer, map[compatKey]*RecordSchema{})
 	return schema, err
 }
 
 // resolve requires the reader's schema to be already compatible with the writer's.
-func (c *SchemaCompatibility) resolve(reader, writer Schema) (schema Schema, resolved bool, err error) {
+//
+// The records currently being resolved are kept in inProgress, so that recursive
+// references to them resolve to a reference instead of recursing forever.
+func (c *SchemaCompatibility) resolve(
+	reader, writer Schema,
+	inProgress map[compatKey]*RecordSchema,
+) (schema Schema, resolved bool, err error) {
 	if reader.Type() == Ref {
 		reader = reader.(*RefSchema).Schema()
 	}
@@ -297,7 +303,7 @@ func (c *SchemaCompatibility) resolve(reader, writer Schema) (schema Schema, res
 				if err := c.compatible(schema, writer); err != nil {
 					continue
 				}
-				sch, _, err := c.resolve(schema, writer)
+				sch, _, err := c.resolve(schema, writer, inProgress)
 				if err != nil {
 					continue
 				}
@@ -310,7 +316,7 @@ func (c *SchemaCompatibility) resolve(reader, writer Schema) (schema Schema, res
 		if writer.Type() == Union {
 			schemas := make([]Schema, 0)
 			for _, schema := range writer.(*UnionSchema).Types() {
-				sch, _, err := c.resolve(reader, schema)
+				sch, _, err := c.resolve(reader, schema, inProgress)
 				if err != nil {
 					return nil, false, err
 				}
@@ -361,7 +367,7 @@ func (c *SchemaCompatibility) resolve(reader, writer Schema) (schema Schema, res
 	if writer.Type() == Union {
 		schemas := make([]Schema, 0)
 		for _, s := range writer.(*UnionSchema).Types() {
-			sch, resolv, err := c.resolve(reader, s)
+			sch, resolv, err := c.resolve(reader, s, inProgress)
 			if err != nil {
 				return nil, false, err
 			}
@@ -376,7 +382,7 @@ func (c *SchemaCompatibility) resolve(reader, writer Schema) (schema Schema, res
 	}
 
 	if writer.Type() == Array {
-		schema, resolved, err = c.resolve(reader.(*ArraySchema).Items(), writer.(*ArraySchema).Items())
+		schema, resolved, err = c.resolve(reader.(*ArraySchema).Items(), writer.(*ArraySchema).Items(), inProgress)
 		if err != nil {
 			return nil, false, err
 		}
@@ -384,7 +390,7 @@ func (c *SchemaCompatibility) resolve(reader, writer Schema) (schema Schema, res
 	}
 
 	if writer.Type() == Map {
-		schema, resolved, err = c.resolve(reader.(*MapSchema).Values(), writer.(*MapSchema).Values())
+		schema, resolved, err = c.resolve(reader.(*MapSchema).Values(), writer.(*MapSchema).Values(), inProgress)
 		if err != nil {
 			return nil, false, err
 		}
@@ -392,16 +398,34 @@ func (c *SchemaCompatibility) resolve(reader, writer Schema) (schema Schema, res
 	}
 
 	if writer.Type() == Record {
-		return c.resolveRecord(reader, writer)
+		return c.resolveRecord(reader, writer, inProgress)
 	}
 
 	return nil, false, fmt.Errorf("failed to resolve composite schema for %s and %s", reader.Type(), writer.Type())
 }
 
-func (c *SchemaCompatibility) resolveRecord(reader, writer Schema) (Schema, bool, error) {
+func (c *SchemaCompatibility) resolveRecord(
+	reader, writer Schema,
+	inProgress map[compatKey]*RecordSchema,
+) (Schema, bool, error) {
 	w := writer.(*RecordSchema)
 	r := reader.(*RecordSchema)
 
+	key := compatKey{reader: r.Fingerprint(), writer: w.Fingerprint()}
+	if rec, ok := inProgress[key]; ok {
+		// The record refers back to itself, refer to the record being resolved.
+		return NewRefSchema(rec), false, nil
+	}
+
+	// The record is created before its fields are resolved, so that recursive
+	// references can point to it. Its fields are set once they are resolved.
+	schema, err := NewRecordSchema(r.Name(), r.Namespace(), nil, WithAliases(r.Aliases()))
+	if err != nil {
+		return nil, false, err
+	}
+	inProgress[key] = schema
+	defer delete(inProgress, key)
+
 	fields := make([]*Field, 0)
 	seen := make(map[string]struct{})
 
@@ -422,7 +446,7 @@ func (c *SchemaCompatibility) resolveRecord(reader, writer Schema) (Schema, bool
 			continue
 		}
 
-		ft, resolv, err := c.resolve(rf.Type(), wf.Type())
+		ft, resolv, err := c.resolve(rf.Type(), wf.Type(), inProgress)
 		if err != nil {
 			return nil, false, err
 		}
@@ -453,11 +477,12 @@ func (c *SchemaCompatibility) resolveRecord(reader, writer Schema) (Schema, bool
 		resolved = true
 	}
 
-	schema, err := NewRecordSchema(r.Name(), r.Namespace(), fields,
-		WithAliases(r.Aliases()),
-		withWriterFingerprintIfResolved(writer.Fingerprint(), resolved),
-	)
-	return schema, resolved, err
+	schema.fields = fields
+	if resolved {
+		wfp := writer.Fingerprint()
+		schema.writerFingerprint = &wfp
+	}
+	return schema, resolved, nil
 }
 
 func isNative(typ Type) bool {
@@ -485,3 +510,79 @@ func isPromotable(writerTyp, readerType Type) bool {
 		return false
 	}
 }
+
+// MigrationPlan describes how data written with a writer schema is read
+// with a reader schema.
+//
+// Fields are named by their path from the top-level schema. Nested record
+// fields are separated by a dot, array items are marked with "[]" and map
+// values with "{}", e.g. "a.b", "arr[].x" or "m{}.y". Union branches do not
+// add to the path, so a field "d" of an optional record "c" is named "c.d".
+type MigrationPlan struct {
+	// Mapped contains the reader fields that are read from a writer field.
+	Mapped []string
+
+	// Defaulted contains the reader fields that are missing in the writer
+	// and are set from their default.
+	Defaulted []string
+
+	// Dropped contains the writer fields that are ignored by the reader.
+	Dropped []string
+}
+
+// PlanMigration returns a plan of how data written by the writer schema
+// will be read by the reader schema. Note that the reader schema is the
+// first argument, as with Compatible and Resolve.
+//
+// Records are found through record fields, unions, arrays and maps.
+// Recursive references are not followed, so the fields of a recursive record
+// are listed once, at the path where the record first appears.
+// It fails if the writer and reader schemas are not compatible.
+// The plan is empty for schemas that contain no records.
+func (c *SchemaCompatibility) PlanMigration(reader, writer Schema) (*MigrationPlan, error) {
+	schema, err := c.Resolve(reader, writer)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &MigrationPlan{}
+	plan.addSchema("", schema)
+	return plan, nil
+}
+
+func (p *MigrationPlan) addSchema(path string, schema Schema) {
+	// References are not followed, they point to a record already in the plan.
+	switch s := schema.(type) {
+	case *RecordSchema:
+		p.addRecord(path, s)
+	case *UnionSchema:
+		for _, typ := range s.Types() {
+			p.addSchema(path, typ)
+		}
+	case *ArraySchema:
+		p.addSchema(path+"[]", s.Items())
+	case *MapSchema:
+		p.addSchema(path+"{}", s.Values())
+	}
+}
+
+func (p *MigrationPlan) addRecord(path string, rec *RecordSchema) {
+	prefix := path
+	if prefix != "" {
+		prefix += "."
+	}
+
+	for _, f := range rec.Fields() {
+		name := prefix + f.Name()
+
+		switch f.action {
+		case FieldIgnore:
+			p.Dropped = append(p.Dropped, name)
+		case FieldSetDefault:
+			p.Defaulted = append(p.Defaulted, name)
+		default:
+			p.Mapped = append(p.Mapped, name)
+			p.addSchema(name, f.Type())
+		}
+	}
+}
@@ -1017,3 +1017,180 @@ func TestSchemaCompatibility_ResolveWithFieldMissingInWriterAndReaderStruct(t *t
 
 	assert.Equal(t, want, result)
 }
+
+func TestSchemaCompatibility_ResolveRecursive(t *testing.T) {
+	w := avro.MustParse(`{
+	"type": "record",
+	"name": "LL",
+	"fields": [
+		{"name": "v", "type": "int"},
+		{"name": "extra", "type": "string"},
+		{"name": "next", "type": ["null", "LL"]}
+	]
+}`)
+	r := avro.MustParse(`{
+	"type": "record",
+	"name": "LL",
+	"fields": [
+		{"name": "v", "type": "long"},
+		{"name": "next", "type": ["null", "LL"]}
+	]
+}`)
+
+	type LLW struct {
+		V     int    `avro:"v"`
+		Extra string `avro:"extra"`
+		Next  *LLW   `avro:"next"`
+	}
+	value := LLW{V: 1, Extra: "foo", Next: &LLW{V: 2, Extra: "bar"}}
+
+	b, err := avro.Marshal(w, value)
+	require.NoError(t, err)
+
+	schema, err := avro.NewSchemaCompatibility().Resolve(r, w)
+	require.NoError(t, err)
+
+	type LLR struct {
+		V    int64 `avro:"v"`
+		Next *LLR  `avro:"next"`
+	}
+	want := LLR{V: 1, Next: &LLR{V: 2}}
+
+	var result LLR
+	err = avro.Unmarshal(schema, b, &result)
+	require.NoError(t, err)
+
+	assert.Equal(t, want, result)
+}
+
+func TestSchemaCompatibility_PlanMigration(t *testing.T) {
+	w := avro.MustParse(`{
+	"type": "record",
+	"name": "test",
+	"fields": [
+		{"name": "a", "type": "string"},
+		{"name": "b", "type": "int"},
+		{"name": "c", "type": {
+			"type": "record",
+			"name": "inner",
+			"fields": [
+				{"name": "d", "type": "long"},
+				{"name": "e", "type": "long"}
+			]
+		}}
+	]
+}`)
+	r := avro.MustParse(`{
+	"type": "record",
+	"name": "test",
+	"fields": [
+		{"name": "z", "type": "string", "aliases": ["a"]},
+		{"name": "c", "type": {
+			"type": "record",
+			"name": "inner",
+			"fields": [
+				{"name": "d", "type": "double"},
+				{"name": "f", "type": "string", "default": "foo"}
+			]
+		}},
+		{"name": "g", "type": ["null", "int"], "default": null}
+	]
+}`)
+
+	plan, err := avro.NewSchemaCompatibility().PlanMigration(r, w)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"z", "c", "c.d"}, plan.Mapped)
+	assert.Equal(t, []string{"c.f", "g"}, plan.Defaulted)
+	assert.Equal(t, []string{"b", "c.e"}, plan.Dropped)
+}
+
+func TestSchemaCompatibility_PlanMigrationNestedContainers(t *testing.T) {
+	tests := []struct {
+		name          string
+		writer        string
+		reader        string
+		wantMapped    []string
+		wantDefaulted []string
+		wantDropped   []string
+	}{
+		{
+			name:          "Nullable Record",
+			writer:        `{"type": "record", "name": "test", "fields": [{"name": "c", "type": ["null", {"type": "record", "name": "inner", "fields": [{"name": "d", "type": "int"}, {"name": "e", "type": "int"}]}]}]}`,
+			reader:        `{"type": "record", "name": "test", "fields": [{"name": "c", "type": ["null", {"type": "record", "name": "inner", "fields": [{"name": "d", "type": "int"}, {"name": "f", "type": "int", "default": 1}]}]}]}`,
+			wantMapped:    []string{"c", "c.d"},
+			wantDefaulted: []string{"c.f"},
+			wantDropped:   []string{"c.e"},
+		},
+		{
+			name:        "Array Of Records",
+			writer:      `{"type": "record", "name": "test", "fields": [{"name": "arr", "type": {"type": "array", "items": {"type": "record", "name": "item", "fields": [{"name": "x", "type": "int"}, {"name": "y", "type": "int"}]}}}]}`,
+			reader:      `{"type": "record", "name": "test", "fields": [{"name": "arr", "type": {"type": "array", "items": {"type": "record", "name": "item", "fields": [{"name": "x", "type": "int"}]}}}]}`,
+			wantMapped:  []string{"arr", "arr[].x"},
+			wantDropped: []string{"arr[].y"},
+		},
+		{
+			name:          "Map Of Records",
+			writer:        `{"type": "record", "name": "test", "fields": [{"name": "m", "type": {"type": "map", "values": {"type": "record", "name": "item", "fields": [{"name": "x", "type": "int"}]}}}]}`,
+			reader:        `{"type": "record", "name": "test", "fields": [{"name": "m", "type": {"type": "map", "values": {"type": "record", "name": "item", "fields": [{"name": "x", "type": "int"}, {"name": "z", "type": "string", "default": ""}]}}}]}`,
+			wantMapped:    []string{"m", "m{}.x"},
+			wantDefaulted: []string{"m{}.z"},
+		},
+		{
+			name:        "Top-Level Array Of Records",
+			writer:      `{"type": "array", "items": {"type": "record", "name": "item", "fields": [{"name": "x", "type": "int"}, {"name": "y", "type": "int"}]}}`,
+			reader:      `{"type": "array", "items": {"type": "record", "name": "item", "fields": [{"name": "x", "type": "int"}]}}`,
+			wantMapped:  []string{"[].x"},
+			wantDropped: []string{"[].y"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := avro.MustParse(test.writer)
+			r := avro.MustParse(test.reader)
+
+			plan, err := avro.NewSchemaCompatibility().PlanMigration(r, w)
+
+			require.NoError(t, err)
+			assert.Equal(t, test.wantMapped, plan.Mapped)
+			assert.Equal(t, test.wantDefaulted, plan.Defaulted)
+			assert.Equal(t, test.wantDropped, plan.Dropped)
+		})
+	}
+}
+
+func TestSchemaCompatibility_PlanMigrationRecursive(t *testing.T) {
+	w := avro.MustParse(`{"type":"record","name":"LL","fields":[{"name":"v","type":"int"},{"name":"e","type":"int"},{"name":"next","type":["null","LL"]}]}`)
+	r := avro.MustParse(`{"type":"record","name":"LL","fields":[{"name":"v","type":"int"},{"name":"next","type":["null","LL"]}]}`)
+
+	plan, err := avro.NewSchemaCompatibility().PlanMigration(r, w)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v", "next"}, plan.Mapped)
+	assert.Empty(t, plan.Defaulted)
+	assert.Equal(t, []string{"e"}, plan.Dropped)
+
+	plan, err = avro.NewSchemaCompatibility().PlanMigration(r, r)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v", "next"}, plan.Mapped)
+	assert.Empty(t, plan.Defaulted)
+	assert.Empty(t, plan.Dropped)
+}
+
+func TestSchemaCompatibility_PlanMigrationNotRecord(t *testing.T) {
+	plan, err := avro.NewSchemaCompatibility().PlanMigration(avro.MustParse("long"), avro.MustParse("int"))
+
+	require.NoError(t, err)
+	assert.Equal(t, &avro.MigrationPlan{}, plan)
+}
+
+func TestSchemaCompatibility_PlanMigrationIncompatible(t *testing.T) {
+	w := avro.MustParse(`{"type": "record", "name": "test", "fields": [{"name": "a", "type": "string"}]}`)
+	r := avro.MustParse(`{"type": "record", "name": "test", "fields": [{"name": "b", "type": "string"}]}`)
+
+	_, err := avro.NewSchemaCompatibility().PlanMigration(r, w)
+
+	assert.Error(t, err)
+}
@@ -1,5 +1,7 @@
 package avro_test
 
+import "time"
+
 type TestInterface interface {
 	SomeFunc() int
 }
@@ -17,6 +19,14 @@ type TestPartialRecord struct {
 	B string `avro:"b"`
 }
 
+type TestExtraFieldsRecord struct {
+	A int64  `avro:"a"`
+	B string `avro:"b"`
+
+	ReceivedAt time.Time
+	Seq        int
+}
+
 type TestNestedRecord struct {
 	A TestRecord `avro:"a"`
 	B TestRecord `avro:"b"`